@@ -0,0 +1,123 @@
+package daowallet
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+)
+
+// Currency identifies a fiat or crypto currency code, such as "BTC" or "USD".
+type Currency string
+
+// Supported currency codes and the number of decimal places the API uses for
+// amounts denominated in them.
+const (
+	CurrencyBTC       Currency = "BTC"
+	CurrencyETH       Currency = "ETH"
+	CurrencyUSDTTRC20 Currency = "USDT-TRC20"
+	CurrencyUSD       Currency = "USD"
+	CurrencyEUR       Currency = "EUR"
+)
+
+// Decimals returns the number of decimal places amounts in this currency are
+// expressed with, e.g. 8 for BTC or 18 for ETH. Unrecognized currencies default
+// to 8, the most common crypto precision.
+func (c Currency) Decimals() int {
+	switch c {
+	case CurrencyBTC:
+		return 8
+	case CurrencyETH:
+		return 18
+	case CurrencyUSDTTRC20:
+		return 6
+	case CurrencyUSD, CurrencyEUR:
+		return 2
+	default:
+		return 8
+	}
+}
+
+// Amount represents a monetary value with arbitrary precision, avoiding the
+// rounding errors float64 introduces for currency math. The zero value
+// represents 0.
+type Amount struct {
+	r *big.Rat
+}
+
+// NewAmount parses a decimal string, such as "0.15498721", into an Amount.
+func NewAmount(s string) (Amount, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Amount{}, fmt.Errorf("daowallet: invalid amount %q", s)
+	}
+	return Amount{r: r}, nil
+}
+
+// Float64 returns the amount as a float64. It exists as a thin shim for code
+// migrating off the old float64-based fields and loses precision the same way
+// those fields did; prefer String or MarshalJSON for anything precision-sensitive.
+func (a Amount) Float64() float64 {
+	if a.r == nil {
+		return 0
+	}
+	f, _ := a.r.Float64()
+	return f
+}
+
+// String returns the amount formatted as a plain decimal, e.g. "0.15498721",
+// without trailing zeros.
+func (a Amount) String() string {
+	if a.r == nil {
+		return "0"
+	}
+	if a.r.IsInt() {
+		return a.r.RatString()
+	}
+	s := a.r.FloatString(18)
+	return trimTrailingZeros(s)
+}
+
+// StringFixed formats the amount with exactly decimals digits after the point,
+// e.g. a.StringFixed(currency.Decimals()) to render it at the currency's precision.
+func (a Amount) StringFixed(decimals int) string {
+	if a.r == nil {
+		return new(big.Rat).FloatString(decimals)
+	}
+	return a.r.FloatString(decimals)
+}
+
+// MarshalJSON emits the amount as a JSON number literal, so it is interchangeable
+// on the wire with the plain numbers the API sends and expects.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts the amount either as a bare JSON number or as a quoted
+// string, so amounts never round-trip through an intermediate float64.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		a.r = nil
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(string(data))
+	if !ok {
+		return fmt.Errorf("daowallet: invalid amount %q", data)
+	}
+	a.r = r
+	return nil
+}
+
+func trimTrailingZeros(s string) string {
+	if !bytes.ContainsRune([]byte(s), '.') {
+		return s
+	}
+	i := len(s)
+	for i > 0 && s[i-1] == '0' {
+		i--
+	}
+	if i > 0 && s[i-1] == '.' {
+		i--
+	}
+	return s[:i]
+}
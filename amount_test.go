@@ -0,0 +1,74 @@
+package daowallet_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"daowallet"
+)
+
+func TestAmount_MarshalJSON(t *testing.T) {
+	// arrange
+	a := mustAmount(t, "0.15498721")
+
+	// act
+	b, err := json.Marshal(a)
+
+	// assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(b) != "0.15498721" {
+		t.Fatalf("got: %s, but expected: %s", b, "0.15498721")
+	}
+}
+
+func TestAmount_UnmarshalJSON(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name string
+		json string
+	}{
+		{name: "bare number", json: `0.01`},
+		{name: "quoted string", json: `"0.01"`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// act
+			var a daowallet.Amount
+			err := json.Unmarshal([]byte(c.json), &a)
+
+			// assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if a.Float64() != 0.01 {
+				t.Fatalf("got: %v, but expected: %v", a.Float64(), 0.01)
+			}
+		})
+	}
+}
+
+func TestCurrency_Decimals(t *testing.T) {
+	// arrange
+	cases := []struct {
+		currency daowallet.Currency
+		decimals int
+	}{
+		{currency: daowallet.CurrencyBTC, decimals: 8},
+		{currency: daowallet.CurrencyETH, decimals: 18},
+		{currency: daowallet.CurrencyUSDTTRC20, decimals: 6},
+		{currency: daowallet.CurrencyUSD, decimals: 2},
+	}
+
+	for _, c := range cases {
+		// act
+		got := c.currency.Decimals()
+
+		// assert
+		if got != c.decimals {
+			t.Fatalf("%s: got: %d, but expected: %d", c.currency, got, c.decimals)
+		}
+	}
+}
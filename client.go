@@ -9,11 +9,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"path"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -24,6 +27,8 @@ const (
 	withdrawalEndpoint    string = "withdrawal/crypto"
 	invoiceNewEndpoint    string = "invoice/new"
 	invoiceStatusEndpoint string = "invoice/status"
+	invoiceListEndpoint   string = "invoice/list"
+	transactionsEndpoint  string = "transactions/history"
 
 	contentTypeHeader string = "Content-Type"
 	jsonContentType   string = "application/json"
@@ -35,24 +40,28 @@ type Client struct {
 	client *http.Client // http client injected by user
 	apiKey string       // api key
 	secret string       // secret for HMAC SHA512 signature
+
+	retry              *retryConfig                                                   // set by WithRetry, nil means no retries
+	limiter            *rate.Limiter                                                  // set by WithRateLimit, nil means unthrottled
+	idempotencyKeyFunc func(ctx context.Context, endpoint string, body []byte) string // set by WithIdempotencyKey
 }
 
 // Address represents user's crypto-address
 type Address struct {
-	ID        int64  `json:"id"`
-	Address   string `json:"address"`
-	Currency  string `json:"currency"`
-	ForeignID string `json:"foreign_id"`
-	Tag       string `json:"tag"`
+	ID        int64    `json:"id"`
+	Address   string   `json:"address"`
+	Currency  Currency `json:"currency"`
+	ForeignID string   `json:"foreign_id"`
+	Tag       string   `json:"tag"`
 }
 
 // Withdrawal represents withdrawal operation info
 type Withdrawal struct {
-	ForeignID        string  `json:"foreign_id"`
-	Type             string  `json:"type"`
-	Amount           float64 `json:"amount"`
-	SenderCurrency   string  `json:"sender_currency"`
-	ReceiverCurrency string  `json:"receiver_currency"`
+	ForeignID        string   `json:"foreign_id"`
+	Type             string   `json:"type"`
+	Amount           Amount   `json:"amount"`
+	SenderCurrency   Currency `json:"sender_currency"`
+	ReceiverCurrency Currency `json:"receiver_currency"`
 }
 
 // Invoice represents issued invoice
@@ -60,14 +69,14 @@ type Invoice struct {
 	ForeignID      string    `json:"foreign_id"`
 	Status         string    `json:"status"`
 	ExpiredAt      time.Time `json:"expired_at"`
-	ClientAmount   float64   `json:"client_amount"`
-	ClientCurrency string    `json:"client_currency"`
+	ClientAmount   Amount    `json:"client_amount"`
+	ClientCurrency Currency  `json:"client_currency"`
 	Addresses      []struct {
-		Address        string  `json:"address"`
-		ExpectedAmount float64 `json:"expected_amount"`
-		CryptoCurrency string  `json:"crypto_currency"`
-		RateUSD        float64 `json:"rate_usd"`
-		RateEUR        float64 `json:"rate_eur"`
+		Address        string   `json:"address"`
+		ExpectedAmount Amount   `json:"expected_amount"`
+		CryptoCurrency Currency `json:"crypto_currency"`
+		RateUSD        Amount   `json:"rate_usd"`
+		RateEUR        Amount   `json:"rate_eur"`
 	} `json:"addresses"`
 }
 
@@ -77,25 +86,58 @@ type ErrorResp struct {
 	Message    string `json:"message"`
 	Err        string `json:"error"`
 	Status     string
+
+	// RetryAfter is how long to wait before retrying, parsed from the
+	// response's Retry-After header. Zero if the header was absent or unparseable.
+	RetryAfter time.Duration
 }
 
 func (e *ErrorResp) Error() string {
 	return fmt.Sprintf("%s (%s)", e.Message, e.Status)
 }
 
+// Is reports whether target is the sentinel error ErrorResp's Err code or HTTP
+// status maps to, so callers can write errors.Is(err, daowallet.ErrInsufficientFunds)
+// instead of string-matching Message.
+func (e *ErrorResp) Is(target error) bool {
+	sentinel := e.sentinel()
+	return sentinel != nil && sentinel == target
+}
+
+func (e *ErrorResp) sentinel() error {
+	if e == nil {
+		return nil
+	}
+	if s, ok := errorSentinelsByCode[e.Err]; ok {
+		return s
+	}
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
 // NewClient creates api client instance with custom daowallet server URL
-func NewClient(c *http.Client, api, key, secret string) *Client {
-	return &Client{
+func NewClient(c *http.Client, api, key, secret string, opts ...ClientOption) *Client {
+	client := &Client{
 		client: c,
 		api:    api,
 		apiKey: key,
 		secret: secret,
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
 }
 
 // NewDefaultClient creates api client instance production daowallet server URL: https://b2b.daowallet.com/api/v2
-func NewDefaultClient(key, secret string) *Client {
-	return &Client{
+func NewDefaultClient(key, secret string, opts ...ClientOption) *Client {
+	client := &Client{
 		api:    "https://b2b.daowallet.com/api/v2",
 		apiKey: key,
 		secret: secret,
@@ -112,13 +154,17 @@ func NewDefaultClient(key, secret string) *Client {
 			Timeout: 10 * time.Minute,
 		},
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
 }
 
 // Addresses obtains customer crypto-address
-func (c *Client) Addresses(ctx context.Context, foreignID, currency string) (Address, error) {
+func (c *Client) Addresses(ctx context.Context, foreignID string, currency Currency) (Address, error) {
 	reqBody := map[string]string{
 		"foreign_id": foreignID,
-		"currency":   currency,
+		"currency":   string(currency),
 	}
 
 	reqJSON, err := json.Marshal(reqBody)
@@ -131,21 +177,7 @@ func (c *Client) Addresses(ctx context.Context, foreignID, currency string) (Add
 		return Address{}, fmt.Errorf("request url creating error: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, addressesURL.String(), bytes.NewBuffer(reqJSON))
-	if err != nil {
-		return Address{}, fmt.Errorf("request creating error: %w", err)
-	}
-
-	sig, err := createHmac(c.secret, reqJSON)
-	if err != nil {
-		return Address{}, fmt.Errorf("hmac signature creationg error: %w", err)
-	}
-
-	req.Header.Set(contentTypeHeader, jsonContentType)
-	req.Header.Set(keyHeader, c.apiKey)
-	req.Header.Set(signatureHeader, sig)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.doRequest(ctx, http.MethodPost, addressesURL.String(), addressesEndpoint, reqJSON, false)
 	if err != nil {
 		return Address{}, fmt.Errorf("request error: %w", err)
 	}
@@ -169,7 +201,7 @@ func (c *Client) Addresses(ctx context.Context, foreignID, currency string) (Add
 }
 
 // Withdraw withdraws cryptocurrency to the customer crypto address
-func (c *Client) Withdraw(ctx context.Context, foreignID string, amount float64, currency, address string) (Withdrawal, error) {
+func (c *Client) Withdraw(ctx context.Context, foreignID string, amount Amount, currency Currency, address string) (Withdrawal, error) {
 	reqBody := map[string]interface{}{
 		"foreign_id": foreignID,
 		"amount":     amount,
@@ -187,21 +219,7 @@ func (c *Client) Withdraw(ctx context.Context, foreignID string, amount float64,
 		return Withdrawal{}, fmt.Errorf("request url creating error: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, withdrawalURL.String(), bytes.NewBuffer(reqJSON))
-	if err != nil {
-		return Withdrawal{}, fmt.Errorf("request creating error: %w", err)
-	}
-
-	sig, err := createHmac(c.secret, reqJSON)
-	if err != nil {
-		return Withdrawal{}, fmt.Errorf("hmac signature creationg error: %w", err)
-	}
-
-	req.Header.Set(contentTypeHeader, jsonContentType)
-	req.Header.Set(keyHeader, c.apiKey)
-	req.Header.Set(signatureHeader, sig)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.doRequest(ctx, http.MethodPost, withdrawalURL.String(), withdrawalEndpoint, reqJSON, true)
 	if err != nil {
 		return Withdrawal{}, fmt.Errorf("request error: %w", err)
 	}
@@ -225,7 +243,7 @@ func (c *Client) Withdraw(ctx context.Context, foreignID string, amount float64,
 }
 
 // InvoiceNew issues an invoice to the customer
-func (c *Client) InvoiceNew(ctx context.Context, amount float64, fiatCurrency string) (Invoice, error) {
+func (c *Client) InvoiceNew(ctx context.Context, amount Amount, fiatCurrency Currency) (Invoice, error) {
 	reqBody := map[string]interface{}{
 		"amount":        amount,
 		"fiat_currency": fiatCurrency,
@@ -241,21 +259,7 @@ func (c *Client) InvoiceNew(ctx context.Context, amount float64, fiatCurrency st
 		return Invoice{}, fmt.Errorf("request url creating error: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, invoiceNewURL.String(), bytes.NewBuffer(reqJSON))
-	if err != nil {
-		return Invoice{}, fmt.Errorf("request creating error: %w", err)
-	}
-
-	sig, err := createHmac(c.secret, reqJSON)
-	if err != nil {
-		return Invoice{}, fmt.Errorf("hmac signature creationg error: %w", err)
-	}
-
-	req.Header.Set(contentTypeHeader, jsonContentType)
-	req.Header.Set(keyHeader, c.apiKey)
-	req.Header.Set(signatureHeader, sig)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.doRequest(ctx, http.MethodPost, invoiceNewURL.String(), invoiceNewEndpoint, reqJSON, true)
 	if err != nil {
 		return Invoice{}, fmt.Errorf("request error: %w", err)
 	}
@@ -287,14 +291,7 @@ func (c *Client) InvoiceStatus(ctx context.Context, id string) (Invoice, error)
 	q.Add("id", id)
 	invoiceStatusURL.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, invoiceStatusURL.String(), nil)
-	if err != nil {
-		return Invoice{}, fmt.Errorf("request creating error: %w", err)
-	}
-
-	req.Header.Set(contentTypeHeader, jsonContentType)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.doRequest(ctx, http.MethodGet, invoiceStatusURL.String(), invoiceStatusEndpoint, nil, false)
 	if err != nil {
 		return Invoice{}, fmt.Errorf("request error: %w", err)
 	}
@@ -315,12 +312,99 @@ func (c *Client) InvoiceStatus(ctx context.Context, id string) (Invoice, error)
 	return inv, nil
 }
 
+// doRequest builds and sends one logical request to endpoint, applying the
+// configured rate limiter and idempotency key before signing, and retrying on
+// 5xx/429 responses or timing-out transport errors per the configured
+// retryConfig. reqJSON may be nil for bodyless requests (e.g. GET). The
+// signature is (re)computed on every attempt, after the idempotency key header
+// is set, since it covers only the body and headers don't feed into it, but any
+// future body mutation must happen before this call signs it.
+func (c *Client) doRequest(ctx context.Context, httpMethod, reqURL, endpoint string, reqJSON []byte, idempotent bool) (*http.Response, error) {
+	maxAttempts := 1
+	if c.retry != nil {
+		maxAttempts = c.retry.maxAttempts
+	}
+
+	var idempotencyKey string
+	if idempotent && c.idempotencyKeyFunc != nil {
+		keyCtx := context.WithValue(ctx, requestInfoKey{}, RequestInfo{Endpoint: endpoint, Attempt: 1})
+		idempotencyKey = c.idempotencyKeyFunc(keyCtx, endpoint, reqJSON)
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("rate limiter wait error: %w", err)
+			}
+		}
+
+		attemptCtx := context.WithValue(ctx, requestInfoKey{}, RequestInfo{Endpoint: endpoint, Attempt: attempt})
+
+		var bodyReader io.Reader
+		if reqJSON != nil {
+			bodyReader = bytes.NewReader(reqJSON)
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, httpMethod, reqURL, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("request creating error: %w", err)
+		}
+
+		req.Header.Set(contentTypeHeader, jsonContentType)
+		if idempotencyKey != "" {
+			req.Header.Set(idempotencyKeyHeader, idempotencyKey)
+		}
+
+		if reqJSON != nil {
+			req.Header.Set(keyHeader, c.apiKey)
+
+			sig, err := createHmac(c.secret, reqJSON)
+			if err != nil {
+				return nil, fmt.Errorf("hmac signature creationg error: %w", err)
+			}
+			req.Header.Set(signatureHeader, sig)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			lastErr = err
+			if attempt == maxAttempts || !isRetryableError(err) {
+				return nil, err
+			}
+			select {
+			case <-time.After(backoff(c.retry, attempt, 0)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			continue
+		}
+
+		if attempt == maxAttempts || !shouldRetryStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		resp.Body.Close()
+		lastErr = fmt.Errorf("request failed with status %s", resp.Status)
+
+		select {
+		case <-time.After(backoff(c.retry, attempt, retryAfter)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, lastErr
+}
+
 func ensureSuccessResponse(resp *http.Response) error {
 	statusOK := resp.StatusCode >= 200 && resp.StatusCode <= 299
 	if !statusOK {
 		errResp := ErrorResp{
 			StatusCode: resp.StatusCode,
 			Status:     resp.Status,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
 		}
 		_ = json.NewDecoder(resp.Body).Decode(&errResp)
 		return &errResp
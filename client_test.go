@@ -77,7 +77,7 @@ func TestClient_Addresses(t *testing.T) {
 	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
 
 	// act
-	res, err := client.Addresses(context.Background(), "user-1250", "BTC")
+	res, err := client.Addresses(context.Background(), "user-1250", daowallet.CurrencyBTC)
 
 	// assert
 	if err != nil {
@@ -86,7 +86,7 @@ func TestClient_Addresses(t *testing.T) {
 
 	expectedResult := daowallet.Address{
 		Address:   "3Hg7gCcrjXYd6WoiV8BHw1MMrBCZY64say",
-		Currency:  "BTC",
+		Currency:  daowallet.CurrencyBTC,
 		ForeignID: "user-1250",
 		ID:        211,
 		Tag:       "",
@@ -111,8 +111,13 @@ func TestClient_Withdraw(t *testing.T) {
 
 	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
 
+	amount, err := daowallet.NewAmount("0.01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
 	// act
-	res, err := client.Withdraw(context.Background(), "user-1250", 0.01, "BTC", "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
+	res, err := client.Withdraw(context.Background(), "user-1250", amount, daowallet.CurrencyBTC, "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
 
 	// assert
 	if err != nil {
@@ -122,9 +127,9 @@ func TestClient_Withdraw(t *testing.T) {
 	expectedResult := daowallet.Withdrawal{
 		ForeignID:        "user-1250",
 		Type:             "withdrawal",
-		Amount:           0.01,
-		SenderCurrency:   "BTC",
-		ReceiverCurrency: "BTC",
+		Amount:           amount,
+		SenderCurrency:   daowallet.CurrencyBTC,
+		ReceiverCurrency: daowallet.CurrencyBTC,
 	}
 	if !reflect.DeepEqual(res, expectedResult) {
 		t.Fatalf("got: %v, but expected: %v", res, expectedResult)
@@ -151,7 +156,7 @@ func TestClient_InvoiceNew(t *testing.T) {
 	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
 
 	// act
-	res, err := client.InvoiceNew(context.Background(), 1250, "USD")
+	res, err := client.InvoiceNew(context.Background(), mustAmount(t, "1250"), daowallet.CurrencyUSD)
 
 	// assert
 	if err != nil {
@@ -162,28 +167,28 @@ func TestClient_InvoiceNew(t *testing.T) {
 		ForeignID:      "eif0Z2bfnkY6WU5mg7gIqTUQBgDs5zWI",
 		Status:         "created",
 		ExpiredAt:      time.Date(2020, 05, 12, 19, 05, 55, 57*1000*1000, time.UTC),
-		ClientAmount:   1250,
-		ClientCurrency: "USD",
+		ClientAmount:   mustAmount(t, "1250"),
+		ClientCurrency: daowallet.CurrencyUSD,
 		Addresses: []struct {
-			Address        string  `json:"address"`
-			ExpectedAmount float64 `json:"expected_amount"`
-			CryptoCurrency string  `json:"crypto_currency"`
-			RateUSD        float64 `json:"rate_usd"`
-			RateEUR        float64 `json:"rate_eur"`
+			Address        string             `json:"address"`
+			ExpectedAmount daowallet.Amount   `json:"expected_amount"`
+			CryptoCurrency daowallet.Currency `json:"crypto_currency"`
+			RateUSD        daowallet.Amount   `json:"rate_usd"`
+			RateEUR        daowallet.Amount   `json:"rate_eur"`
 		}{
 			{
 				Address:        "3LAgvMFh11mvsjYxUzUNGaEWTzmC1nnzPZ",
-				ExpectedAmount: 0.15498721,
-				CryptoCurrency: "BTC",
-				RateUSD:        8871.7,
-				RateEUR:        8173.8,
+				ExpectedAmount: mustAmount(t, "0.15498721"),
+				CryptoCurrency: daowallet.CurrencyBTC,
+				RateUSD:        mustAmount(t, "8871.7"),
+				RateEUR:        mustAmount(t, "8173.8"),
 			},
 			{
 				Address:        "0x0468bc919B99809155157C7aB101d2eeD84efb37",
-				ExpectedAmount: 7.21671128,
-				CryptoCurrency: "ETH",
-				RateUSD:        190.53,
-				RateEUR:        175.53,
+				ExpectedAmount: mustAmount(t, "7.21671128"),
+				CryptoCurrency: daowallet.CurrencyETH,
+				RateUSD:        mustAmount(t, "190.53"),
+				RateEUR:        mustAmount(t, "175.53"),
 			},
 		},
 	}
@@ -221,28 +226,28 @@ func TestClient_InvoiceStatus(t *testing.T) {
 		ForeignID:      "KMNTQCMWX8VSowpqGmnwYIDuchusB0B5",
 		Status:         "created",
 		ExpiredAt:      time.Date(2020, 05, 12, 19, 05, 55, 57*1000*1000, time.UTC),
-		ClientAmount:   1250,
-		ClientCurrency: "USD",
+		ClientAmount:   mustAmount(t, "1250"),
+		ClientCurrency: daowallet.CurrencyUSD,
 		Addresses: []struct {
-			Address        string  `json:"address"`
-			ExpectedAmount float64 `json:"expected_amount"`
-			CryptoCurrency string  `json:"crypto_currency"`
-			RateUSD        float64 `json:"rate_usd"`
-			RateEUR        float64 `json:"rate_eur"`
+			Address        string             `json:"address"`
+			ExpectedAmount daowallet.Amount   `json:"expected_amount"`
+			CryptoCurrency daowallet.Currency `json:"crypto_currency"`
+			RateUSD        daowallet.Amount   `json:"rate_usd"`
+			RateEUR        daowallet.Amount   `json:"rate_eur"`
 		}{
 			{
 				Address:        "3LAgvMFh11mvsjYxUzUNGaEWTzmC1nnzPZ",
-				ExpectedAmount: 0.15498721,
-				CryptoCurrency: "BTC",
-				RateUSD:        8871.7,
-				RateEUR:        8173.8,
+				ExpectedAmount: mustAmount(t, "0.15498721"),
+				CryptoCurrency: daowallet.CurrencyBTC,
+				RateUSD:        mustAmount(t, "8871.7"),
+				RateEUR:        mustAmount(t, "8173.8"),
 			},
 			{
 				Address:        "0x0468bc919B99809155157C7aB101d2eeD84efb37",
-				ExpectedAmount: 7.21671128,
-				CryptoCurrency: "ETH",
-				RateUSD:        190.53,
-				RateEUR:        175.53,
+				ExpectedAmount: mustAmount(t, "7.21671128"),
+				CryptoCurrency: daowallet.CurrencyETH,
+				RateUSD:        mustAmount(t, "190.53"),
+				RateEUR:        mustAmount(t, "175.53"),
 			},
 		},
 	}
@@ -251,6 +256,16 @@ func TestClient_InvoiceStatus(t *testing.T) {
 	}
 }
 
+func mustAmount(t *testing.T, s string) daowallet.Amount {
+	t.Helper()
+
+	a, err := daowallet.NewAmount(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return a
+}
+
 func assertHeader(h *http.Header, name, value string) {
 	if h.Get(name) != value {
 		panic(fmt.Errorf("got header: %v, but expected: %v", h.Get(name), value))
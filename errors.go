@@ -0,0 +1,29 @@
+package daowallet
+
+import "errors"
+
+// Sentinel errors returned wrapped in an *ErrorResp, matched via errors.Is
+// against the API's error code or HTTP status. Use errors.Is(err, daowallet.ErrX)
+// instead of string-matching ErrorResp.Message, which can change between API versions.
+var (
+	ErrInsufficientFunds  = errors.New("daowallet: insufficient funds")
+	ErrInvalidAddress     = errors.New("daowallet: invalid address")
+	ErrAddressNotFound    = errors.New("daowallet: address not found")
+	ErrInvoiceExpired     = errors.New("daowallet: invoice expired")
+	ErrDuplicateForeignID = errors.New("daowallet: duplicate foreign_id")
+	ErrRateLimited        = errors.New("daowallet: rate limited")
+	ErrUnauthorized       = errors.New("daowallet: unauthorized")
+	ErrSignatureMismatch  = errors.New("daowallet: signature mismatch")
+)
+
+// errorSentinelsByCode maps the API's "error" field to the sentinel error
+// ErrorResp.Is reports. ErrUnauthorized and ErrRateLimited are not keyed here:
+// they are mapped from HTTP status 401 and 429 when the error code is not in this map.
+var errorSentinelsByCode = map[string]error{
+	"insufficient_funds":   ErrInsufficientFunds,
+	"invalid_address":      ErrInvalidAddress,
+	"address_not_found":    ErrAddressNotFound,
+	"invoice_expired":      ErrInvoiceExpired,
+	"duplicate_foreign_id": ErrDuplicateForeignID,
+	"signature_mismatch":   ErrSignatureMismatch,
+}
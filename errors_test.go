@@ -0,0 +1,92 @@
+package daowallet_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"daowallet"
+)
+
+func TestErrorResp_Is(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name       string
+		statusCode int
+		errorCode  string
+		want       error
+	}{
+		{name: "insufficient funds", statusCode: http.StatusBadRequest, errorCode: "insufficient_funds", want: daowallet.ErrInsufficientFunds},
+		{name: "invalid address", statusCode: http.StatusBadRequest, errorCode: "invalid_address", want: daowallet.ErrInvalidAddress},
+		{name: "address not found", statusCode: http.StatusNotFound, errorCode: "address_not_found", want: daowallet.ErrAddressNotFound},
+		{name: "invoice expired", statusCode: http.StatusBadRequest, errorCode: "invoice_expired", want: daowallet.ErrInvoiceExpired},
+		{name: "duplicate foreign_id", statusCode: http.StatusConflict, errorCode: "duplicate_foreign_id", want: daowallet.ErrDuplicateForeignID},
+		{name: "signature mismatch", statusCode: http.StatusBadRequest, errorCode: "signature_mismatch", want: daowallet.ErrSignatureMismatch},
+		{name: "unauthorized by status", statusCode: http.StatusUnauthorized, errorCode: "", want: daowallet.ErrUnauthorized},
+		{name: "rate limited by status", statusCode: http.StatusTooManyRequests, errorCode: "", want: daowallet.ErrRateLimited},
+		{name: "unmapped error code", statusCode: http.StatusInternalServerError, errorCode: "something_else", want: nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// act
+			errResp := &daowallet.ErrorResp{StatusCode: c.statusCode, Err: c.errorCode}
+
+			// assert
+			if c.want == nil {
+				for _, sentinel := range allErrorSentinels() {
+					if errors.Is(errResp, sentinel) {
+						t.Fatalf("expected no sentinel to match, but matched: %v", sentinel)
+					}
+				}
+				return
+			}
+			if !errors.Is(errResp, c.want) {
+				t.Fatalf("expected errors.Is to match %v", c.want)
+			}
+		})
+	}
+}
+
+func allErrorSentinels() []error {
+	return []error{
+		daowallet.ErrInsufficientFunds,
+		daowallet.ErrInvalidAddress,
+		daowallet.ErrAddressNotFound,
+		daowallet.ErrInvoiceExpired,
+		daowallet.ErrDuplicateForeignID,
+		daowallet.ErrRateLimited,
+		daowallet.ErrUnauthorized,
+		daowallet.ErrSignatureMismatch,
+	}
+}
+
+func TestEnsureSuccessResponse_RetryAfterSeconds(t *testing.T) {
+	// arrange
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"statusCode":429,"message":"too many requests","error":"rate_limited"}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
+
+	// act
+	_, err := client.Addresses(context.Background(), "user-1250", daowallet.CurrencyBTC)
+
+	// assert
+	var errResp *daowallet.ErrorResp
+	if !errors.As(err, &errResp) {
+		t.Fatalf("expected *daowallet.ErrorResp, got: %v", err)
+	}
+	if !errors.Is(errResp, daowallet.ErrRateLimited) {
+		t.Fatalf("expected errors.Is to match ErrRateLimited, got: %v", errResp)
+	}
+	if errResp.RetryAfter != 2*time.Second {
+		t.Fatalf("got RetryAfter: %v, but expected: %v", errResp.RetryAfter, 2*time.Second)
+	}
+}
@@ -0,0 +1,300 @@
+package daowallet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultHistoryPageLimit is the page size used when a Query's Limit is unset.
+const defaultHistoryPageLimit = 100
+
+// TransactionType distinguishes a deposit from a withdrawal in Transactions history.
+type TransactionType string
+
+// Transaction types accepted by TransactionsQuery.Type.
+const (
+	TransactionTypeDeposit    TransactionType = "deposit"
+	TransactionTypeWithdrawal TransactionType = "withdrawal"
+)
+
+// Transaction represents one deposit or withdrawal returned by Client.Transactions.
+type Transaction struct {
+	ForeignID string          `json:"foreign_id"`
+	Type      TransactionType `json:"type"`
+	Amount    Amount          `json:"amount"`
+	Currency  Currency        `json:"currency"`
+	Status    string          `json:"status"`
+	TxID      string          `json:"tx_id"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// TransactionsQuery filters the results of Client.Transactions. Zero-valued
+// fields are omitted from the request.
+type TransactionsQuery struct {
+	Currency  Currency
+	ForeignID string
+	Type      TransactionType
+	From      time.Time
+	To        time.Time
+	Status    string
+	Limit     int // page size fetched per request; defaults to defaultHistoryPageLimit
+}
+
+// InvoicesQuery filters the results of Client.Invoices. Zero-valued fields are
+// omitted from the request.
+type InvoicesQuery struct {
+	Currency  Currency
+	ForeignID string
+	Status    string
+	From      time.Time
+	To        time.Time
+	Limit     int // page size fetched per request; defaults to defaultHistoryPageLimit
+}
+
+// TransactionsIterator iterates the pages of a Client.Transactions call,
+// fetching the next page transparently as the caller advances past the
+// current one.
+type TransactionsIterator struct {
+	client *Client
+	query  TransactionsQuery
+	limit  int
+	offset int
+	buf    []Transaction
+	idx    int
+	done   bool
+	closed bool
+	err    error
+}
+
+// Transactions returns an iterator over the deposit/withdrawal history matching query.
+func (c *Client) Transactions(ctx context.Context, query TransactionsQuery) *TransactionsIterator {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultHistoryPageLimit
+	}
+	return &TransactionsIterator{client: c, query: query, limit: limit, idx: -1}
+}
+
+// Next advances the iterator to the next Transaction, fetching the next page
+// from the API when the current one is exhausted. It returns false once the
+// history is exhausted, the iterator is closed, or a page fetch fails (including
+// because ctx is done); check Err to tell exhaustion from failure. ctx is only
+// used for page fetches, so entries already fetched are still returned after it is done.
+func (it *TransactionsIterator) Next(ctx context.Context) bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+
+	it.idx++
+	for it.idx >= len(it.buf) {
+		if it.done {
+			return false
+		}
+
+		page, err := it.client.fetchTransactionsPage(ctx, it.query, it.offset, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.buf = page
+		it.idx = 0
+		it.offset += len(page)
+		if len(page) < it.limit {
+			it.done = true
+		}
+		if len(page) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Value returns the Transaction the most recent call to Next advanced to.
+func (it *TransactionsIterator) Value() Transaction {
+	return it.buf[it.idx]
+}
+
+// Err returns the error, if any, that caused Next to return false.
+func (it *TransactionsIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator, so a caller can abandon it before the history is exhausted.
+func (it *TransactionsIterator) Close() {
+	it.closed = true
+}
+
+func (c *Client) fetchTransactionsPage(ctx context.Context, query TransactionsQuery, offset, limit int) ([]Transaction, error) {
+	transactionsURL, err := joinURL(c.api, transactionsEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("request url creating error: %w", err)
+	}
+
+	q := transactionsURL.Query()
+	if query.Currency != "" {
+		q.Set("currency", string(query.Currency))
+	}
+	if query.ForeignID != "" {
+		q.Set("foreign_id", query.ForeignID)
+	}
+	if query.Type != "" {
+		q.Set("type", string(query.Type))
+	}
+	if !query.From.IsZero() {
+		q.Set("from", query.From.Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		q.Set("to", query.To.Format(time.RFC3339))
+	}
+	if query.Status != "" {
+		q.Set("status", query.Status)
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+	transactionsURL.RawQuery = q.Encode()
+
+	resp, err := c.doRequest(ctx, http.MethodGet, transactionsURL.String(), transactionsEndpoint, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := ensureSuccessResponse(resp); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	respBody := struct {
+		Data []Transaction `json:"data"`
+	}{}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("response unmarshaling error: %w", err)
+	}
+
+	return respBody.Data, nil
+}
+
+// InvoicesIterator iterates the pages of a Client.Invoices call, fetching the
+// next page transparently as the caller advances past the current one.
+type InvoicesIterator struct {
+	client *Client
+	query  InvoicesQuery
+	limit  int
+	offset int
+	buf    []Invoice
+	idx    int
+	done   bool
+	closed bool
+	err    error
+}
+
+// Invoices returns an iterator over the invoices matching query.
+func (c *Client) Invoices(ctx context.Context, query InvoicesQuery) *InvoicesIterator {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultHistoryPageLimit
+	}
+	return &InvoicesIterator{client: c, query: query, limit: limit, idx: -1}
+}
+
+// Next advances the iterator to the next Invoice, fetching the next page from
+// the API when the current one is exhausted. It returns false once the
+// history is exhausted, the iterator is closed, or a page fetch fails (including
+// because ctx is done); check Err to tell exhaustion from failure. ctx is only
+// used for page fetches, so entries already fetched are still returned after it is done.
+func (it *InvoicesIterator) Next(ctx context.Context) bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+
+	it.idx++
+	for it.idx >= len(it.buf) {
+		if it.done {
+			return false
+		}
+
+		page, err := it.client.fetchInvoicesPage(ctx, it.query, it.offset, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.buf = page
+		it.idx = 0
+		it.offset += len(page)
+		if len(page) < it.limit {
+			it.done = true
+		}
+		if len(page) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Value returns the Invoice the most recent call to Next advanced to.
+func (it *InvoicesIterator) Value() Invoice {
+	return it.buf[it.idx]
+}
+
+// Err returns the error, if any, that caused Next to return false.
+func (it *InvoicesIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator, so a caller can abandon it before the history is exhausted.
+func (it *InvoicesIterator) Close() {
+	it.closed = true
+}
+
+func (c *Client) fetchInvoicesPage(ctx context.Context, query InvoicesQuery, offset, limit int) ([]Invoice, error) {
+	invoiceListURL, err := joinURL(c.api, invoiceListEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("request url creating error: %w", err)
+	}
+
+	q := invoiceListURL.Query()
+	if query.Currency != "" {
+		q.Set("currency", string(query.Currency))
+	}
+	if query.ForeignID != "" {
+		q.Set("foreign_id", query.ForeignID)
+	}
+	if query.Status != "" {
+		q.Set("status", query.Status)
+	}
+	if !query.From.IsZero() {
+		q.Set("from", query.From.Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		q.Set("to", query.To.Format(time.RFC3339))
+	}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+	invoiceListURL.RawQuery = q.Encode()
+
+	resp, err := c.doRequest(ctx, http.MethodGet, invoiceListURL.String(), invoiceListEndpoint, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := ensureSuccessResponse(resp); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	respBody := struct {
+		Data []Invoice `json:"data"`
+	}{}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("response unmarshaling error: %w", err)
+	}
+
+	return respBody.Data, nil
+}
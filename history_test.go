@@ -0,0 +1,118 @@
+package daowallet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"daowallet"
+)
+
+func TestClient_Transactions_PaginatesUntilExhausted(t *testing.T) {
+	// arrange
+	var gotOffsets []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotOffsets = append(gotOffsets, r.URL.Query().Get("offset"))
+
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"data":[
+				{"foreign_id":"tx-1","type":"deposit","amount":0.01,"currency":"BTC","status":"confirmed","tx_id":"a1","created_at":"2020-05-12T19:05:55Z"},
+				{"foreign_id":"tx-2","type":"withdrawal","amount":0.02,"currency":"BTC","status":"confirmed","tx_id":"a2","created_at":"2020-05-12T19:06:55Z"}
+			]}`))
+		case "2":
+			w.Write([]byte(`{"data":[
+				{"foreign_id":"tx-3","type":"deposit","amount":0.03,"currency":"BTC","status":"confirmed","tx_id":"a3","created_at":"2020-05-12T19:07:55Z"}
+			]}`))
+		default:
+			w.Write([]byte(`{"data":[]}`))
+		}
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
+
+	// act
+	it := client.Transactions(context.Background(), daowallet.TransactionsQuery{Limit: 2})
+	var got []string
+	for it.Next(context.Background()) {
+		got = append(got, it.Value().ForeignID)
+	}
+
+	// assert
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"tx-1", "tx-2", "tx-3"}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Fatalf("got: %v, but expected: %v", got, expected)
+	}
+
+	expectedOffsets := []string{"0", "2"}
+	if fmt.Sprint(gotOffsets) != fmt.Sprint(expectedOffsets) {
+		t.Fatalf("got offsets: %v, but expected: %v", gotOffsets, expectedOffsets)
+	}
+}
+
+func TestTransactionsIterator_Close(t *testing.T) {
+	// arrange
+	var requests int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"data":[
+			{"foreign_id":"tx-1","type":"deposit","amount":0.01,"currency":"BTC","status":"confirmed","tx_id":"a1","created_at":"2020-05-12T19:05:55Z"},
+			{"foreign_id":"tx-2","type":"deposit","amount":0.02,"currency":"BTC","status":"confirmed","tx_id":"a2","created_at":"2020-05-12T19:06:55Z"}
+		]}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
+
+	// act
+	it := client.Transactions(context.Background(), daowallet.TransactionsQuery{Limit: 2})
+	it.Next(context.Background())
+	it.Close()
+
+	// assert
+	if it.Next(context.Background()) {
+		t.Fatal("expected Next to return false after Close")
+	}
+	if requests != 1 {
+		t.Fatalf("got: %d requests, but expected: %d", requests, 1)
+	}
+}
+
+func TestClient_Invoices_PaginatesUntilExhausted(t *testing.T) {
+	// arrange
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"data":[{"foreign_id":"inv-1","status":"paid","client_amount":10,"client_currency":"USD","addresses":[]}]}`))
+		default:
+			w.Write([]byte(`{"data":[]}`))
+		}
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret)
+
+	// act
+	it := client.Invoices(context.Background(), daowallet.InvoicesQuery{Status: "paid"})
+	var got []string
+	for it.Next(context.Background()) {
+		got = append(got, it.Value().ForeignID)
+	}
+
+	// assert
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint([]string{"inv-1"}) {
+		t.Fatalf("got: %v, but expected: %v", got, []string{"inv-1"})
+	}
+}
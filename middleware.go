@@ -0,0 +1,119 @@
+package daowallet
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const idempotencyKeyHeader string = "Idempotency-Key"
+
+// ClientOption configures optional Client behavior, applied by NewClient and
+// NewDefaultClient in the order given.
+type ClientOption func(*Client)
+
+// retryConfig holds the exponential backoff parameters set by WithRetry.
+type retryConfig struct {
+	maxAttempts int
+	base        time.Duration
+	cap         time.Duration
+}
+
+// WithRetry makes Client retry requests that fail with a 5xx or 429 response,
+// or a timing-out net.Error, up to maxAttempts times total. Attempts back off
+// exponentially with full jitter: the wait before retry n is random in
+// [0, min(cap, base*2^(n-1))], or the Retry-After response header's delay when
+// the server sends one.
+func WithRetry(maxAttempts int, base, cap time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retry = &retryConfig{maxAttempts: maxAttempts, base: base, cap: cap}
+	}
+}
+
+// WithRateLimit throttles outbound requests to rps requests per second, allowing
+// bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithIdempotencyKey injects an Idempotency-Key header, derived by f from the
+// request's endpoint and body, on Withdraw and InvoiceNew calls so that a
+// retried POST cannot create a duplicate transfer. f is called once per logical
+// request, not once per retry attempt, so it must return the same key across
+// attempts of the same call; the ctx passed to it carries a RequestInfo (with
+// Attempt always 1, since f runs before any retry attempt) for observability.
+func WithIdempotencyKey(f func(ctx context.Context, endpoint string, body []byte) string) ClientOption {
+	return func(c *Client) {
+		c.idempotencyKeyFunc = f
+	}
+}
+
+type requestInfoKey struct{}
+
+// RequestInfo carries per-attempt metadata about a request made by Client, for
+// observability. It is attached to the context passed to a WithIdempotencyKey
+// callback and can be retrieved with RequestInfoFromContext.
+type RequestInfo struct {
+	Endpoint string
+	Attempt  int
+}
+
+// RequestInfoFromContext returns the RequestInfo attached to ctx, if any.
+func RequestInfoFromContext(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info, ok
+}
+
+// shouldRetryStatus reports whether an HTTP response status warrants a retry.
+func shouldRetryStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// isRetryableError reports whether a transport error warrants a retry.
+func isRetryableError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter parses a Retry-After header value in either the delay-seconds
+// or HTTP-date form, returning 0 if v is empty or unparseable.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// backoff computes how long to wait before retry attempt (1-indexed), honoring
+// retryAfter when the server specified one and otherwise using exponential
+// backoff with full jitter: a random wait in [0, min(cfg.cap, cfg.base*2^(attempt-1))].
+func backoff(cfg *retryConfig, attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	d := cfg.base * time.Duration(1<<uint(attempt-1))
+	if d <= 0 || d > cfg.cap {
+		d = cfg.cap
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
@@ -0,0 +1,156 @@
+package daowallet_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"daowallet"
+)
+
+func TestClient_Withdraw_RetriesOn5xx(t *testing.T) {
+	// arrange
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"data":{"foreign_id":"user-1250","type":"withdrawal","amount":0.01,"sender_currency":"BTC","receiver_currency":"BTC"}}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret,
+		daowallet.WithRetry(5, time.Millisecond, 10*time.Millisecond))
+
+	// act
+	res, err := client.Withdraw(context.Background(), "user-1250", mustAmount(t, "0.01"), daowallet.CurrencyBTC, "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
+
+	// assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Fatalf("got: %d attempts, but expected: %d", attempts, 3)
+	}
+	if res.ForeignID != "user-1250" {
+		t.Fatalf("got: %v, but expected foreign_id: %v", res, "user-1250")
+	}
+}
+
+func TestClient_Withdraw_StopsRetryingAfterMaxAttempts(t *testing.T) {
+	// arrange
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret,
+		daowallet.WithRetry(2, time.Millisecond, 10*time.Millisecond))
+
+	// act
+	_, err := client.Withdraw(context.Background(), "user-1250", mustAmount(t, "0.01"), daowallet.CurrencyBTC, "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
+
+	// assert
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if atomic.LoadInt32(&attempts) != 2 {
+		t.Fatalf("got: %d attempts, but expected: %d", attempts, 2)
+	}
+}
+
+func TestClient_Withdraw_IdempotencyKeyStableAcrossRetries(t *testing.T) {
+	// arrange
+	var attempts int32
+	var keys []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if atomic.AddInt32(&attempts, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"data":{"foreign_id":"user-1250","type":"withdrawal","amount":0.01,"sender_currency":"BTC","receiver_currency":"BTC"}}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret,
+		daowallet.WithRetry(3, time.Millisecond, 10*time.Millisecond),
+		daowallet.WithIdempotencyKey(func(ctx context.Context, endpoint string, body []byte) string {
+			return "fixed-key"
+		}))
+
+	// act
+	_, err := client.Withdraw(context.Background(), "user-1250", mustAmount(t, "0.01"), daowallet.CurrencyBTC, "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
+
+	// assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 2 || keys[0] != "fixed-key" || keys[1] != "fixed-key" {
+		t.Fatalf("got: %v, but expected the same key on every attempt", keys)
+	}
+}
+
+func TestClient_Withdraw_IdempotencyKeyFuncSeesRequestInfo(t *testing.T) {
+	// arrange
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"foreign_id":"user-1250","type":"withdrawal","amount":0.01,"sender_currency":"BTC","receiver_currency":"BTC"}}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	var gotInfo daowallet.RequestInfo
+	var gotOK bool
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret,
+		daowallet.WithIdempotencyKey(func(ctx context.Context, endpoint string, body []byte) string {
+			gotInfo, gotOK = daowallet.RequestInfoFromContext(ctx)
+			return "fixed-key"
+		}))
+
+	// act
+	_, err := client.Withdraw(context.Background(), "user-1250", mustAmount(t, "0.01"), daowallet.CurrencyBTC, "3AtTjKpqmD8Zr6rvcX9cvACTNxMz3praot")
+
+	// assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gotOK {
+		t.Fatal("expected RequestInfoFromContext to find a RequestInfo in the idempotency key callback's ctx")
+	}
+	if gotInfo.Endpoint == "" {
+		t.Fatalf("got empty endpoint in RequestInfo: %v", gotInfo)
+	}
+}
+
+func TestClient_Addresses_RateLimited(t *testing.T) {
+	// arrange
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":211,"address":"3Hg7gCcrjXYd6WoiV8BHw1MMrBCZY64say","currency":"BTC","foreign_id":"user-1250","tag":""}}`))
+	})
+	httpClient, teardown := testingHTTPClient(h)
+	defer teardown()
+
+	client := daowallet.NewClient(httpClient, testAPI, testKey, testSecret,
+		daowallet.WithRateLimit(1000, 1))
+
+	// act
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Addresses(context.Background(), "user-1250", daowallet.CurrencyBTC); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// assert
+	if elapsed := time.Since(start); elapsed < 2*time.Millisecond {
+		t.Fatalf("expected the rate limiter to slow down 3 calls at 1000rps/burst 1, elapsed: %v", elapsed)
+	}
+}
@@ -0,0 +1,212 @@
+package daowallet
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Webhook event types sent in the envelope's "type" field.
+const (
+	EventTypeDeposit        string = "deposit"
+	EventTypeWithdrawal     string = "withdrawal"
+	EventTypeInvoicePaid    string = "invoice.paid"
+	EventTypeInvoiceExpired string = "invoice.expired"
+)
+
+// DepositEvent represents a deposit status change delivered via webhook.
+type DepositEvent struct {
+	ForeignID string   `json:"foreign_id"`
+	Address   string   `json:"address"`
+	Currency  Currency `json:"currency"`
+	Amount    Amount   `json:"amount"`
+	Status    string   `json:"status"`
+	TxID      string   `json:"tx_id"`
+}
+
+// IdempotencyKey identifies this event for dedup purposes across replayed deliveries.
+func (e DepositEvent) IdempotencyKey() string {
+	return e.ForeignID + ":" + e.Status
+}
+
+// WithdrawalEvent represents a withdrawal status change delivered via webhook.
+type WithdrawalEvent struct {
+	ForeignID        string   `json:"foreign_id"`
+	Type             string   `json:"type"`
+	Amount           Amount   `json:"amount"`
+	SenderCurrency   Currency `json:"sender_currency"`
+	ReceiverCurrency Currency `json:"receiver_currency"`
+	Status           string   `json:"status"`
+	TxID             string   `json:"tx_id"`
+}
+
+// IdempotencyKey identifies this event for dedup purposes across replayed deliveries.
+func (e WithdrawalEvent) IdempotencyKey() string {
+	return e.ForeignID + ":" + e.Status
+}
+
+// InvoicePaidEvent represents an invoice transitioning to the paid status.
+type InvoicePaidEvent struct {
+	ForeignID      string   `json:"foreign_id"`
+	Status         string   `json:"status"`
+	ClientAmount   Amount   `json:"client_amount"`
+	ClientCurrency Currency `json:"client_currency"`
+}
+
+// IdempotencyKey identifies this event for dedup purposes across replayed deliveries.
+func (e InvoicePaidEvent) IdempotencyKey() string {
+	return e.ForeignID + ":" + e.Status
+}
+
+// InvoiceExpiredEvent represents an invoice transitioning to the expired status.
+type InvoiceExpiredEvent struct {
+	ForeignID      string   `json:"foreign_id"`
+	Status         string   `json:"status"`
+	ClientAmount   Amount   `json:"client_amount"`
+	ClientCurrency Currency `json:"client_currency"`
+}
+
+// IdempotencyKey identifies this event for dedup purposes across replayed deliveries.
+func (e InvoiceExpiredEvent) IdempotencyKey() string {
+	return e.ForeignID + ":" + e.Status
+}
+
+// Handlers holds the user-supplied callbacks dispatched by a webhook mux. A nil
+// callback makes its event type unhandled: NewWebhookMux responds 400 to it.
+type Handlers struct {
+	OnDeposit        func(ctx context.Context, event DepositEvent) error
+	OnWithdrawal     func(ctx context.Context, event WithdrawalEvent) error
+	OnInvoicePaid    func(ctx context.Context, event InvoicePaidEvent) error
+	OnInvoiceExpired func(ctx context.Context, event InvoiceExpiredEvent) error
+}
+
+// webhookEnvelope is the outer shape of every delivery: a type discriminator
+// plus the type-specific payload, mirroring the `data` envelope used by the API.
+type webhookEnvelope struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// handlerError wraps an error returned by a user-supplied Handlers callback, as
+// opposed to one NewWebhookMux raised itself while parsing the delivery. It
+// marks the error as the receiving application's own failure rather than a
+// malformed or unhandled payload, so NewWebhookMux can respond 500 instead of 400: a 4xx tells
+// DAOWallet the delivery is permanently bad, but a failed callback (e.g. a
+// database outage) is transient and the event should be redelivered.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string { return e.err.Error() }
+func (e *handlerError) Unwrap() error { return e.err }
+
+// NewWebhookMux builds an http.Handler that verifies the X-Processing-Signature
+// header against hex(HMAC-SHA512(secret, body)) using hmac.Equal, unmarshals the
+// body into the typed event matching its "type" field, and dispatches it to the
+// matching callback in handlers. It responds 401 on a signature mismatch, 400
+// on a malformed or unhandled payload, and 500 if the callback itself returns
+// an error, so DAOWallet redelivers the event instead of treating it as
+// permanently rejected; otherwise 200 once the callback returns.
+func NewWebhookMux(secret string, handlers Handlers) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sig := r.Header.Get(signatureHeader)
+		if sig == "" {
+			http.Error(w, fmt.Sprintf("missing %s header", signatureHeader), http.StatusBadRequest)
+			return
+		}
+
+		expectedSig, err := createHmac(secret, body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("hmac signature creation error: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		if !hmac.Equal([]byte(sig), []byte(expectedSig)) {
+			http.Error(w, "signature mismatch", http.StatusUnauthorized)
+			return
+		}
+
+		env := webhookEnvelope{}
+		if err := json.Unmarshal(body, &env); err != nil {
+			http.Error(w, fmt.Sprintf("payload unmarshaling error: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if err := dispatchWebhookEvent(r.Context(), handlers, env); err != nil {
+			var handlerErr *handlerError
+			if errors.As(err, &handlerErr) {
+				http.Error(w, handlerErr.Error(), http.StatusInternalServerError)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func dispatchWebhookEvent(ctx context.Context, handlers Handlers, env webhookEnvelope) error {
+	switch env.Type {
+	case EventTypeDeposit:
+		if handlers.OnDeposit == nil {
+			return fmt.Errorf("no handler registered for %q events", env.Type)
+		}
+		event := DepositEvent{}
+		if err := json.Unmarshal(env.Data, &event); err != nil {
+			return fmt.Errorf("deposit event unmarshaling error: %w", err)
+		}
+		if err := handlers.OnDeposit(ctx, event); err != nil {
+			return &handlerError{err: err}
+		}
+		return nil
+	case EventTypeWithdrawal:
+		if handlers.OnWithdrawal == nil {
+			return fmt.Errorf("no handler registered for %q events", env.Type)
+		}
+		event := WithdrawalEvent{}
+		if err := json.Unmarshal(env.Data, &event); err != nil {
+			return fmt.Errorf("withdrawal event unmarshaling error: %w", err)
+		}
+		if err := handlers.OnWithdrawal(ctx, event); err != nil {
+			return &handlerError{err: err}
+		}
+		return nil
+	case EventTypeInvoicePaid:
+		if handlers.OnInvoicePaid == nil {
+			return fmt.Errorf("no handler registered for %q events", env.Type)
+		}
+		event := InvoicePaidEvent{}
+		if err := json.Unmarshal(env.Data, &event); err != nil {
+			return fmt.Errorf("invoice paid event unmarshaling error: %w", err)
+		}
+		if err := handlers.OnInvoicePaid(ctx, event); err != nil {
+			return &handlerError{err: err}
+		}
+		return nil
+	case EventTypeInvoiceExpired:
+		if handlers.OnInvoiceExpired == nil {
+			return fmt.Errorf("no handler registered for %q events", env.Type)
+		}
+		event := InvoiceExpiredEvent{}
+		if err := json.Unmarshal(env.Data, &event); err != nil {
+			return fmt.Errorf("invoice expired event unmarshaling error: %w", err)
+		}
+		if err := handlers.OnInvoiceExpired(ctx, event); err != nil {
+			return &handlerError{err: err}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown webhook event type %q", env.Type)
+	}
+}
@@ -0,0 +1,143 @@
+package daowallet_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"daowallet"
+)
+
+func signedWebhookRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("X-Processing-Signature", hmacSHA512Hex(testSecret, body))
+	return req
+}
+
+func hmacSHA512Hex(secret, body string) string {
+	h := hmac.New(sha512.New, []byte(secret))
+	h.Write([]byte(body))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func TestNewWebhookMux_Deposit(t *testing.T) {
+	// arrange
+	var got daowallet.DepositEvent
+	mux := daowallet.NewWebhookMux(testSecret, daowallet.Handlers{
+		OnDeposit: func(ctx context.Context, event daowallet.DepositEvent) error {
+			got = event
+			return nil
+		},
+	})
+
+	body := `{"type":"deposit","data":{"foreign_id":"user-1250","address":"3Hg7gCcrjXYd6WoiV8BHw1MMrBCZY64say","currency":"BTC","amount":0.01,"status":"confirmed","tx_id":"abc123"}}`
+	req := signedWebhookRequest(t, body)
+	rec := httptest.NewRecorder()
+
+	// act
+	mux.ServeHTTP(rec, req)
+
+	// assert
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status: %d, but expected: %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	expected := daowallet.DepositEvent{
+		ForeignID: "user-1250",
+		Address:   "3Hg7gCcrjXYd6WoiV8BHw1MMrBCZY64say",
+		Currency:  daowallet.CurrencyBTC,
+		Amount:    mustAmount(t, "0.01"),
+		Status:    "confirmed",
+		TxID:      "abc123",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got: %v, but expected: %v", got, expected)
+	}
+}
+
+func TestNewWebhookMux_SignatureMismatch(t *testing.T) {
+	// arrange
+	mux := daowallet.NewWebhookMux(testSecret, daowallet.Handlers{
+		OnDeposit: func(ctx context.Context, event daowallet.DepositEvent) error {
+			t.Fatal("handler should not be called on signature mismatch")
+			return nil
+		},
+	})
+
+	body := `{"type":"deposit","data":{"foreign_id":"user-1250"}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("X-Processing-Signature", "deadbeef")
+	rec := httptest.NewRecorder()
+
+	// act
+	mux.ServeHTTP(rec, req)
+
+	// assert
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("got status: %d, but expected: %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestNewWebhookMux_UnknownType(t *testing.T) {
+	// arrange
+	mux := daowallet.NewWebhookMux(testSecret, daowallet.Handlers{})
+
+	body := `{"type":"unknown","data":{}}`
+	req := signedWebhookRequest(t, body)
+	rec := httptest.NewRecorder()
+
+	// act
+	mux.ServeHTTP(rec, req)
+
+	// assert
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("got status: %d, but expected: %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestNewWebhookMux_HandlerError(t *testing.T) {
+	// arrange
+	mux := daowallet.NewWebhookMux(testSecret, daowallet.Handlers{
+		OnDeposit: func(ctx context.Context, event daowallet.DepositEvent) error {
+			return errors.New("database unavailable")
+		},
+	})
+
+	body := `{"type":"deposit","data":{"foreign_id":"user-1250","address":"3Hg7gCcrjXYd6WoiV8BHw1MMrBCZY64say","currency":"BTC","amount":0.01,"status":"confirmed","tx_id":"abc123"}}`
+	req := signedWebhookRequest(t, body)
+	rec := httptest.NewRecorder()
+
+	// act
+	mux.ServeHTTP(rec, req)
+
+	// assert
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("got status: %d, but expected: %d (body: %s)", rec.Code, http.StatusInternalServerError, rec.Body.String())
+	}
+}
+
+func TestNewWebhookMux_UnhandledType(t *testing.T) {
+	// arrange
+	mux := daowallet.NewWebhookMux(testSecret, daowallet.Handlers{})
+
+	body := `{"type":"invoice.paid","data":{"foreign_id":"inv-1","status":"paid"}}`
+	req := signedWebhookRequest(t, body)
+	rec := httptest.NewRecorder()
+
+	// act
+	mux.ServeHTTP(rec, req)
+
+	// assert
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("got status: %d, but expected: %d", rec.Code, http.StatusBadRequest)
+	}
+}